@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CredentialSource produces credentials from a single source (static env
+// vars, an OIDC token file, the ECS instance metadata service, a CLI
+// profile, ...). A source that isn't configured/applicable for the
+// current environment should return a *NotEnableError so Chain moves on
+// to the next source instead of treating it as a hard failure.
+type CredentialSource interface {
+	Name() string
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// ChainOptions configures NewChain.
+type ChainOptions struct {
+	// StickyTTL is how long Chain keeps using the source that last
+	// produced credentials before it re-probes earlier sources in the
+	// list. Defaults to 15 minutes.
+	StickyTTL time.Duration
+}
+
+// Chain tries each CredentialSource in order and returns the first one
+// that produces valid credentials, remembering which source last
+// succeeded so subsequent refreshes skip the earlier, non-applicable
+// sources for StickyTTL. Chain.Credentials has the getCredentialsFunc
+// signature, so a Chain can be passed directly to NewUpdater to have the
+// whole chain refreshed by a single Updater.
+type Chain struct {
+	sources   []CredentialSource
+	stickyTTL time.Duration
+
+	mu          sync.Mutex
+	lastSource  int
+	lastSuccess time.Time
+}
+
+// NewChain builds a Chain over sources, tried in order.
+func NewChain(sources []CredentialSource, opts ChainOptions) *Chain {
+	ttl := opts.StickyTTL
+	if ttl <= 0 {
+		ttl = 15 * time.Minute
+	}
+	return &Chain{
+		sources:    sources,
+		stickyTTL:  ttl,
+		lastSource: -1,
+	}
+}
+
+// DefaultChain mirrors the AWS SDK's default credential chain: static
+// AK/SK from the environment, then RRSA/OIDC, then the ECS instance RAM
+// role, then the Alibaba Cloud CLI's shared credentials file.
+func DefaultChain() *Chain {
+	return NewChain([]CredentialSource{
+		EnvSource{},
+		NewOIDCTokenFileSource(""),
+		&ECSRAMRoleSource{},
+		&CLIProfileSource{},
+	}, ChainOptions{})
+}
+
+func (c *Chain) Credentials(ctx context.Context) (*Credentials, error) {
+	start := c.stickySourceIndex()
+
+	cred, lastErr := c.probe(ctx, start, len(c.sources))
+	if cred != nil {
+		return cred, nil
+	}
+
+	// the sticky source stopped applying; re-probe from the top once
+	// before giving up, in case an earlier source has since become usable
+	if start > 0 {
+		var err error
+		cred, err = c.probe(ctx, 0, start)
+		if cred != nil {
+			return cred, nil
+		}
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	if lastErr != nil {
+		return nil, fmt.Errorf("no credential source succeeded: %w", lastErr)
+	}
+	return nil, &NotEnableError{}
+}
+
+// probe tries sources[from:to] in order, treating a hard error from one
+// source as a reason to keep trying later ones rather than aborting the
+// whole chain — only the last hard error seen is kept, to surface to the
+// caller if every source ultimately fails.
+func (c *Chain) probe(ctx context.Context, from, to int) (*Credentials, error) {
+	var lastErr error
+	for i := from; i < to; i++ {
+		cred, err := c.sources[i].Credentials(ctx)
+		if err != nil {
+			if _, ok := err.(*NotEnableError); !ok {
+				lastErr = fmt.Errorf("credential source %q: %w", c.sources[i].Name(), err)
+			}
+			continue
+		}
+		c.remember(i)
+		return cred, nil
+	}
+	return nil, lastErr
+}
+
+func (c *Chain) stickySourceIndex() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lastSource < 0 || time.Since(c.lastSuccess) > c.stickyTTL {
+		return 0
+	}
+	return c.lastSource
+}
+
+func (c *Chain) remember(i int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastSource = i
+	c.lastSuccess = time.Now()
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrCircuitOpen is returned by Credentials when the retry policy's circuit
+// breaker has tripped after too many consecutive refresh failures, so
+// callers can fail fast instead of waiting out a refresh attempt that is
+// very likely to fail again.
+var ErrCircuitOpen = errors.New("provider: credential refresh circuit breaker is open")
+
+type errClass int
+
+const (
+	errClassUnknown errClass = iota
+	errClassThrottling
+	errClassAuth
+	errClassNetwork
+)
+
+// classifyError makes a best-effort guess at the kind of failure
+// getCredentials returned, so the retry policy can back off throttling
+// errors longer and trip the breaker sooner on auth errors, which are
+// unlikely to be resolved by retrying.
+func classifyError(err error) errClass {
+	if err == nil {
+		return errClassUnknown
+	}
+
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "throttling"), strings.Contains(msg, "too many requests"):
+		return errClassThrottling
+	case strings.Contains(msg, "forbidden"), strings.Contains(msg, "invalidaccesskeyid"),
+		strings.Contains(msg, "accessdenied"), strings.Contains(msg, "invalidtoken"),
+		strings.Contains(msg, "signaturedoesnotmatch"):
+		return errClassAuth
+	case strings.Contains(msg, "timeout"), strings.Contains(msg, "connection refused"),
+		strings.Contains(msg, "no such host"):
+		return errClassNetwork
+	default:
+		return errClassUnknown
+	}
+}
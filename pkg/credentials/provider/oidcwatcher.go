@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	// oidcWatchDebounce coalesces bursts of rotation events (the kubelet
+	// typically writes the new token via a rename of a temp file, which
+	// shows up as more than one fsnotify event) into a single refresh.
+	oidcWatchDebounce = 2 * time.Second
+
+	// oidcPollInterval is used as a fallback on platforms where fsnotify
+	// isn't available.
+	oidcPollInterval = 30 * time.Second
+)
+
+// startOIDCTokenFileWatch watches path for writes/renames and triggers an
+// out-of-band refresh when it changes, falling back to a stat-based poll
+// if fsnotify can't be used.
+func (u *Updater) startOIDCTokenFileWatch(ctx context.Context, path string) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		u.logger().Debug(fmt.Sprintf("fsnotify unavailable (%s); polling %s for rotation instead", err, path))
+		go u.pollOIDCTokenFile(ctx, path)
+		return
+	}
+
+	dir := filepath.Dir(path)
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		u.logger().Debug(fmt.Sprintf("failed to watch %s (%s); polling %s for rotation instead", dir, err, path))
+		go u.pollOIDCTokenFile(ctx, path)
+		return
+	}
+
+	go u.runOIDCWatchLoop(ctx, watcher, path)
+}
+
+func (u *Updater) runOIDCWatchLoop(ctx context.Context, watcher *fsnotify.Watcher, path string) {
+	defer watcher.Close()
+
+	trigger := make(chan struct{}, 1)
+	var debounce *time.Timer
+	defer func() {
+		if debounce != nil {
+			debounce.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			// A kubelet-projected token is rotated by an atomic symlink
+			// swap: it renames the volume's ..data directory entry to
+			// point at the new content, and the token file itself (a
+			// symlink into ..data) never gets its own write/rename event.
+			// So we don't filter on event.Name == path here; any
+			// write/create/rename in the token's directory is treated as
+			// a possible rotation.
+			if debounce == nil {
+				debounce = time.AfterFunc(oidcWatchDebounce, func() {
+					select {
+					case trigger <- struct{}{}:
+					default:
+					}
+				})
+			} else {
+				debounce.Reset(oidcWatchDebounce)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			u.logger().Debug(fmt.Sprintf("oidc token file watch error: %s", err))
+		case <-trigger:
+			u.logger().Debug(fmt.Sprintf("oidc token file %s may have rotated, refreshing credentials", path))
+			if err := u.refreshCred(ctx); err != nil {
+				u.logger().Error(err, fmt.Sprintf("out-of-band refresh after oidc token rotation failed: %s", err))
+			}
+		}
+	}
+}
+
+func (u *Updater) pollOIDCTokenFile(ctx context.Context, path string) {
+	ticker := time.NewTicker(oidcPollInterval)
+	defer ticker.Stop()
+
+	lastModTime := fileModTime(path)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			modTime := fileModTime(path)
+			if !modTime.IsZero() && modTime.After(lastModTime) {
+				lastModTime = modTime
+				u.logger().Debug(fmt.Sprintf("oidc token file %s rotated, refreshing credentials", path))
+				if err := u.refreshCred(ctx); err != nil {
+					u.logger().Error(err, fmt.Sprintf("out-of-band refresh after oidc token rotation failed: %s", err))
+				}
+			}
+		}
+	}
+}
+
+func fileModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
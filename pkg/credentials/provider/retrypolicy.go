@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RetryPolicy decides how long to wait before the next refresh attempt
+// after refreshCredForLoop sees a failure, and whether to attempt at all.
+// Returning ok=false tells the caller to stop retrying and open the
+// circuit breaker for the returned delay; while it is open, Credentials
+// returns ErrCircuitOpen and the refresh loop makes no attempt.
+type RetryPolicy interface {
+	NextDelay(attempt int, lastErr error) (delay time.Duration, ok bool)
+}
+
+// BackoffOptions configures NewDefaultRetryPolicy.
+type BackoffOptions struct {
+	// Base is the delay before the first retry.
+	Base time.Duration
+	// Max caps the computed backoff delay, before jitter is applied.
+	Max time.Duration
+	// JitterFraction is the fraction of the computed delay (0-1) that is
+	// randomized away, implementing "full jitter" at JitterFraction=1.
+	JitterFraction float64
+	// BreakerThreshold is the number of consecutive failures after which
+	// the breaker trips.
+	BreakerThreshold int
+	// BreakerCooldown is how long the breaker stays open before allowing
+	// another attempt.
+	BreakerCooldown time.Duration
+}
+
+// DefaultBackoffOptions returns the options used by NewDefaultRetryPolicy
+// when none are supplied.
+func DefaultBackoffOptions() BackoffOptions {
+	return BackoffOptions{
+		Base:             time.Second,
+		Max:              time.Minute,
+		JitterFraction:   1,
+		BreakerThreshold: 5,
+		BreakerCooldown:  5 * time.Minute,
+	}
+}
+
+// NewDefaultRetryPolicy returns the RetryPolicy used by Updater when
+// UpdaterOptions.RetryPolicy is not set: exponential backoff with full
+// jitter, with throttling errors backed off harder and auth errors
+// tripping the breaker sooner, since retrying those is unlikely to help.
+func NewDefaultRetryPolicy(opts BackoffOptions) RetryPolicy {
+	return &defaultRetryPolicy{opts: opts}
+}
+
+type defaultRetryPolicy struct {
+	opts BackoffOptions
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+}
+
+func (p *defaultRetryPolicy) NextDelay(attempt int, lastErr error) (time.Duration, bool) {
+	p.mu.Lock()
+	p.consecutiveFailures++
+	failures := p.consecutiveFailures
+	p.mu.Unlock()
+
+	class := classifyError(lastErr)
+	breakerThreshold := p.opts.BreakerThreshold
+	if class == errClassAuth {
+		breakerThreshold = 2
+	}
+	if failures >= breakerThreshold {
+		return p.opts.BreakerCooldown, false
+	}
+
+	base := p.opts.Base
+	if class == errClassThrottling {
+		base *= 4
+	}
+
+	delay := time.Duration(math.Min(float64(p.opts.Max), float64(base)*math.Pow(2, float64(attempt))))
+	if p.opts.JitterFraction > 0 {
+		delay = time.Duration(float64(delay) * (1 - p.opts.JitterFraction*rand.Float64()))
+	}
+	return delay, true
+}
+
+func (p *defaultRetryPolicy) reset() {
+	p.mu.Lock()
+	p.consecutiveFailures = 0
+	p.mu.Unlock()
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+const (
+	EnvAccessKeyId     = "ALIBABA_CLOUD_ACCESS_KEY_ID"
+	EnvAccessKeySecret = "ALIBABA_CLOUD_ACCESS_KEY_SECRET"
+	EnvSecurityToken   = "ALIBABA_CLOUD_SECURITY_TOKEN"
+)
+
+// staticCredentialExpiration is used for credentials that don't actually
+// expire (a static AK/SK), so Updater never bothers refreshing them.
+const staticCredentialExpiration = 100 * 365 * 24 * time.Hour
+
+// EnvSource reads a static AK/SK, optionally with an STS security token,
+// from the environment.
+type EnvSource struct{}
+
+func (EnvSource) Name() string { return "Env" }
+
+func (EnvSource) Credentials(ctx context.Context) (*Credentials, error) {
+	ak := os.Getenv(EnvAccessKeyId)
+	sk := os.Getenv(EnvAccessKeySecret)
+	if ak == "" || sk == "" {
+		return nil, &NotEnableError{}
+	}
+
+	return &Credentials{
+		AccessKeyId:     ak,
+		AccessKeySecret: sk,
+		SecurityToken:   os.Getenv(EnvSecurityToken),
+		Expiration:      time.Now().Add(staticCredentialExpiration),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/aliyun/credentials-go/credentials"
+)
+
+const (
+	EnvRoleArn         = "ALIBABA_CLOUD_ROLE_ARN"
+	EnvOidcProviderArn = "ALIBABA_CLOUD_OIDC_PROVIDER_ARN"
+	EnvOidcTokenFile   = "ALIBABA_CLOUD_OIDC_TOKEN_FILE"
+
+	defaultOIDCRoleSessionName = "ack-ram-tool"
+)
+
+// OIDCTokenFileSource exchanges the RRSA projected service account token
+// for temporary credentials via STS AssumeRoleWithOIDC. The underlying
+// credentials-go SDK re-reads the token file on every exchange, so token
+// rotation by the kubelet is picked up on the next scheduled refresh; see
+// UpdaterOptions.WatchOIDCTokenFile for a way to trigger that refresh
+// immediately instead of waiting on Updater's ticker.
+type OIDCTokenFileSource struct {
+	RoleSessionName string
+
+	cred credentials.Credential
+}
+
+// NewOIDCTokenFileSource returns a source that reads
+// ALIBABA_CLOUD_ROLE_ARN, ALIBABA_CLOUD_OIDC_PROVIDER_ARN and
+// ALIBABA_CLOUD_OIDC_TOKEN_FILE. roleSessionName may be empty, in which
+// case a default is used.
+func NewOIDCTokenFileSource(roleSessionName string) *OIDCTokenFileSource {
+	return &OIDCTokenFileSource{RoleSessionName: roleSessionName}
+}
+
+func (s *OIDCTokenFileSource) Name() string { return "OIDCTokenFile" }
+
+func (s *OIDCTokenFileSource) Credentials(ctx context.Context) (*Credentials, error) {
+	roleArn := os.Getenv(EnvRoleArn)
+	providerArn := os.Getenv(EnvOidcProviderArn)
+	tokenFile := os.Getenv(EnvOidcTokenFile)
+	if roleArn == "" || providerArn == "" || tokenFile == "" {
+		return nil, &NotEnableError{}
+	}
+
+	if s.cred == nil {
+		sessionName := s.RoleSessionName
+		if sessionName == "" {
+			sessionName = defaultOIDCRoleSessionName
+		}
+
+		config := new(credentials.Config).
+			SetType("oidc_role_arn").
+			SetRoleArn(roleArn).
+			SetOIDCProviderArn(providerArn).
+			SetOIDCTokenFilePath(tokenFile).
+			SetRoleSessionName(sessionName)
+
+		cred, err := credentials.NewCredential(config)
+		if err != nil {
+			return nil, err
+		}
+		s.cred = cred
+	}
+
+	return credentialsFromTeaCredential(s.cred)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/ini.v1"
+)
+
+const defaultCLIProfileName = "default"
+
+// CLIProfileSource reads a static AK/SK (and optional STS token) from the
+// Alibaba Cloud CLI's shared credentials file, ~/.alibabacloud/credentials.
+type CLIProfileSource struct {
+	// Path defaults to ~/.alibabacloud/credentials.
+	Path string
+	// Profile defaults to "default".
+	Profile string
+}
+
+func (s *CLIProfileSource) Name() string { return "CLIProfile" }
+
+func (s *CLIProfileSource) Credentials(ctx context.Context) (*Credentials, error) {
+	path := s.Path
+	if path == "" {
+		path = defaultCLIProfilePath()
+	}
+	if path == "" {
+		return nil, &NotEnableError{}
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, &NotEnableError{}
+	}
+
+	cfg, err := ini.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("load CLI credentials file %q: %w", path, err)
+	}
+
+	profile := s.Profile
+	if profile == "" {
+		profile = defaultCLIProfileName
+	}
+	section, err := cfg.GetSection(profile)
+	if err != nil {
+		return nil, &NotEnableError{}
+	}
+
+	ak := section.Key("access_key_id").String()
+	sk := section.Key("access_key_secret").String()
+	if ak == "" || sk == "" {
+		return nil, &NotEnableError{}
+	}
+
+	return &Credentials{
+		AccessKeyId:     ak,
+		AccessKeySecret: sk,
+		SecurityToken:   section.Key("sts_token").String(),
+		Expiration:      time.Now().Add(staticCredentialExpiration),
+	}, nil
+}
+
+func defaultCLIProfilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".alibabacloud", "credentials")
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Status is a snapshot of an Updater's refresh history, suitable for
+// exposing to an operator via HealthHandler or a StatusSink.
+type Status struct {
+	LastSuccessTime     time.Time `json:"lastSuccessTime,omitempty"`
+	LastErrorTime       time.Time `json:"lastErrorTime,omitempty"`
+	LastError           string    `json:"lastError,omitempty"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	Expiration          time.Time `json:"expiration,omitempty"`
+	NextRefreshTime     time.Time `json:"nextRefreshTime,omitempty"`
+}
+
+// Healthy reports whether the most recent refresh attempt succeeded.
+func (s Status) Healthy() bool {
+	return s.ConsecutiveFailures == 0
+}
+
+// StatusSink is notified on every refresh attempt, successful or not, so
+// that embedders can push it into Prometheus, a CRD status field, or
+// similar.
+type StatusSink interface {
+	OnRefresh(status Status)
+}
+
+// Status returns a snapshot of the updater's current refresh status.
+func (u *Updater) Status() Status {
+	u.lockForStatus.RLock()
+	defer u.lockForStatus.RUnlock()
+
+	return u.status
+}
+
+func (u *Updater) recordSuccess(now time.Time, expiration time.Time) {
+	u.lockForStatus.Lock()
+	u.status.LastSuccessTime = now
+	u.status.LastError = ""
+	u.status.ConsecutiveFailures = 0
+	u.status.Expiration = expiration
+	u.status.NextRefreshTime = now.Add(u.refreshPeriod)
+	status := u.status
+	u.lockForStatus.Unlock()
+
+	u.notifyStatusSink(status)
+}
+
+func (u *Updater) recordFailure(now time.Time, err error) {
+	u.lockForStatus.Lock()
+	u.status.LastErrorTime = now
+	u.status.LastError = err.Error()
+	u.status.ConsecutiveFailures++
+	u.status.NextRefreshTime = now.Add(u.refreshPeriod)
+	status := u.status
+	u.lockForStatus.Unlock()
+
+	u.notifyStatusSink(status)
+}
+
+func (u *Updater) notifyStatusSink(status Status) {
+	if u.StatusSink != nil {
+		u.StatusSink.OnRefresh(status)
+	}
+}
+
+// HealthHandler returns an http.Handler that reports the updater's Status
+// as JSON, responding 200 when the last refresh succeeded and 503
+// otherwise, so it can be wired up as a liveness/readiness probe for a
+// long-lived sidecar or daemon.
+func (u *Updater) HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := u.Status()
+
+		w.Header().Set("Content-Type", "application/json")
+		if status.Healthy() {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		_ = json.NewEncoder(w).Encode(status)
+	})
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/credentials-go/credentials"
+)
+
+// assumedTokenLifetime is used when a wrapped credentials-go Credential
+// doesn't expose its own expiration, so Updater still knows to refresh it
+// well before STS would actually reject it.
+const assumedTokenLifetime = 55 * time.Minute
+
+func credentialsFromTeaCredential(cred credentials.Credential) (*Credentials, error) {
+	ak, err := cred.GetAccessKeyId()
+	if err != nil {
+		return nil, err
+	}
+	sk, err := cred.GetAccessKeySecret()
+	if err != nil {
+		return nil, err
+	}
+	token, err := cred.GetSecurityToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Credentials{
+		AccessKeyId:     tea.StringValue(ak),
+		AccessKeySecret: tea.StringValue(sk),
+		SecurityToken:   tea.StringValue(token),
+		Expiration:      time.Now().Add(assumedTokenLifetime),
+	}, nil
+}
@@ -22,6 +22,16 @@ type Updater struct {
 	cred        *Credentials
 	lockForCred sync.RWMutex
 
+	status        Status
+	lockForStatus sync.RWMutex
+	StatusSink    StatusSink
+
+	RetryPolicy      RetryPolicy
+	lockForCircuit   sync.RWMutex
+	circuitOpenUntil time.Time
+
+	watchOIDCTokenFile string
+
 	Logger  Logger
 	nowFunc func() time.Time
 }
@@ -30,6 +40,21 @@ type UpdaterOptions struct {
 	ExpiryWindow  time.Duration
 	RefreshPeriod time.Duration
 	Logger        Logger
+
+	// StatusSink, if set, is notified on every refresh attempt. See
+	// Updater.Status and Updater.HealthHandler for other ways to observe
+	// refresh health.
+	StatusSink StatusSink
+
+	// RetryPolicy controls the backoff and circuit breaker used by the
+	// background refresh loop. Defaults to NewDefaultRetryPolicy(DefaultBackoffOptions()).
+	RetryPolicy RetryPolicy
+
+	// WatchOIDCTokenFile, if set, is the path to the RRSA projected
+	// service account token file (ALIBABA_CLOUD_OIDC_TOKEN_FILE). When
+	// the kubelet rotates it, Updater triggers an out-of-band refresh
+	// instead of waiting for RefreshPeriod to tick.
+	WatchOIDCTokenFile string
 }
 
 func NewUpdater(getter getCredentialsFunc, opts UpdaterOptions) *Updater {
@@ -40,13 +65,26 @@ func NewUpdater(getter getCredentialsFunc, opts UpdaterOptions) *Updater {
 		getCredentials:             getter,
 		cred:                       nil,
 		lockForCred:                sync.RWMutex{},
+		StatusSink:                 opts.StatusSink,
+		RetryPolicy:                opts.RetryPolicy,
+		watchOIDCTokenFile:         opts.WatchOIDCTokenFile,
 		Logger:                     opts.Logger,
 		nowFunc:                    time.Now,
 	}
+	if u.RetryPolicy == nil {
+		// constructed once and reused, so defaultRetryPolicy.consecutiveFailures
+		// actually accumulates across refreshCredForLoop's retry loop instead
+		// of resetting on every attempt
+		u.RetryPolicy = NewDefaultRetryPolicy(DefaultBackoffOptions())
+	}
 	return u
 }
 
 func (u *Updater) Start(ctx context.Context) {
+	if u.watchOIDCTokenFile != "" {
+		u.startOIDCTokenFileWatch(ctx, u.watchOIDCTokenFile)
+	}
+
 	if u.refreshPeriod <= 0 {
 		return
 	}
@@ -73,6 +111,9 @@ loop:
 
 func (u *Updater) Credentials(ctx context.Context) (*Credentials, error) {
 	if u.Expired() {
+		if u.circuitOpen() {
+			return nil, ErrCircuitOpen
+		}
 		if err := u.refreshCred(ctx); err != nil {
 			return nil, err
 		}
@@ -88,12 +129,14 @@ func (u *Updater) refreshCredForLoop(ctx context.Context) {
 	if !u.expired(u.expiryWindowForRefreshLoop) {
 		return
 	}
+	if u.circuitOpen() {
+		return
+	}
 
 	u.logger().Debug(fmt.Sprintf("start refresh credentials, current expiration: %s",
 		exp.Format("2006-01-02T15:04:05Z")))
 
-	maxRetry := 5
-	for i := 0; i < maxRetry; i++ {
+	for attempt := 0; ; attempt++ {
 		err := u.refreshCred(ctx)
 		if err == nil {
 			return
@@ -101,28 +144,62 @@ func (u *Updater) refreshCredForLoop(ctx context.Context) {
 		if _, ok := err.(*NotEnableError); ok {
 			return
 		}
-		if i < maxRetry-1 {
-			time.Sleep(time.Second * time.Duration(i))
+
+		delay, ok := u.retryPolicy().NextDelay(attempt, err)
+		if !ok {
+			u.openCircuit(delay)
+			return
 		}
+		time.Sleep(delay)
 	}
 }
 
 func (u *Updater) refreshCred(ctx context.Context) error {
 	cred, err := u.getCredentials(ctx)
 	if err != nil {
-		if _, ok := err.(*NotEnableError); ok {
-			return err
+		if _, ok := err.(*NotEnableError); !ok {
+			u.logger().Error(err, fmt.Sprintf("refresh credentials failed: %s", err))
+			u.recordFailure(u.now(), err)
+		} else {
+			u.notifyStatusSink(u.Status())
 		}
-		u.logger().Error(err, fmt.Sprintf("refresh credentials failed: %s", err))
 		return err
 	}
 	u.logger().Debug(fmt.Sprintf("refreshed credentials, expiration: %s",
 		cred.Expiration.Format("2006-01-02T15:04:05Z")))
 
 	u.setCred(*cred)
+	u.recordSuccess(u.now(), cred.Expiration)
+	u.closeCircuit()
 	return nil
 }
 
+func (u *Updater) retryPolicy() RetryPolicy {
+	return u.RetryPolicy
+}
+
+func (u *Updater) openCircuit(cooldown time.Duration) {
+	u.lockForCircuit.Lock()
+	defer u.lockForCircuit.Unlock()
+	u.circuitOpenUntil = u.now().Add(cooldown)
+}
+
+func (u *Updater) closeCircuit() {
+	u.lockForCircuit.Lock()
+	defer u.lockForCircuit.Unlock()
+	u.circuitOpenUntil = time.Time{}
+
+	if r, ok := u.RetryPolicy.(interface{ reset() }); ok {
+		r.reset()
+	}
+}
+
+func (u *Updater) circuitOpen() bool {
+	u.lockForCircuit.RLock()
+	defer u.lockForCircuit.RUnlock()
+	return u.circuitOpenUntil.After(u.now())
+}
+
 func (u *Updater) setCred(cred Credentials) {
 	u.lockForCred.Lock()
 	defer u.lockForCred.Unlock()
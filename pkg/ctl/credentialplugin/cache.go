@@ -0,0 +1,108 @@
+package credentialplugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AliyunContainerService/ack-ram-tool/pkg/types"
+)
+
+const (
+	cacheDirName      = "ack-ram-tool"
+	cacheLockFileName = "credentials.lock"
+
+	// cacheExpiryWindow mirrors provider.Updater's own expiry window, so a
+	// cached credential is treated as expired a little before the STS
+	// server would actually reject it.
+	cacheExpiryWindow = 5 * time.Minute
+)
+
+func defaultCacheDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".kube", "cache", cacheDirName)
+}
+
+// fileCache is an on-disk cache of ExecCredentials, one file per cluster,
+// user and apiVersion, shared by every ack-ram-tool invocation on the machine.
+type fileCache struct {
+	dir string
+}
+
+func newFileCache(dir string) *fileCache {
+	return &fileCache{dir: dir}
+}
+
+// cacheKey returns a stable, filesystem-safe name for the cache entry for a
+// given cluster id, the current user, and the negotiated apiVersion, so
+// that different users on the same machine don't share (or clobber) each
+// other's cached credentials, and two kubeconfigs for the same cluster
+// requesting different ExecCredential apiVersions don't serve each other
+// a cached object with the wrong apiVersion.
+func cacheKey(clusterId, apiVersion string) string {
+	h := sha256.Sum256([]byte(clusterId + "|" + currentUserHash() + "|" + apiVersion))
+	return fmt.Sprintf("%s-%s", clusterId, hex.EncodeToString(h[:])[:12])
+}
+
+func currentUserHash() string {
+	uid := os.Getenv("USER")
+	if uid == "" {
+		uid = os.Getenv("USERNAME")
+	}
+	return uid
+}
+
+func (c *fileCache) credentialPath(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+func (c *fileCache) lockPath() string {
+	return filepath.Join(c.dir, cacheLockFileName)
+}
+
+// get returns the cached ExecCredential for key, if one exists and is not
+// within expiryWindow of its expiration.
+func (c *fileCache) get(key string, expiryWindow time.Duration, now time.Time) (*types.ExecCredential, bool) {
+	data, err := os.ReadFile(c.credentialPath(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var cred types.ExecCredential
+	if err := json.Unmarshal(data, &cred); err != nil {
+		return nil, false
+	}
+	if cred.Status == nil || cred.Status.ExpirationTimestamp == nil {
+		return nil, false
+	}
+	if cred.Status.ExpirationTimestamp.Add(-expiryWindow).Before(now) {
+		return nil, false
+	}
+	return &cred, true
+}
+
+// set writes cred to the cache entry for key, replacing any previous value.
+func (c *fileCache) set(key string, cred *types.ExecCredential) error {
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return fmt.Errorf("create credential cache dir %q: %w", c.dir, err)
+	}
+
+	data, err := json.Marshal(cred)
+	if err != nil {
+		return fmt.Errorf("marshal cached credential: %w", err)
+	}
+
+	path := c.credentialPath(key)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write credential cache file: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
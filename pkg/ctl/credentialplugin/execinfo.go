@@ -0,0 +1,43 @@
+package credentialplugin
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/AliyunContainerService/ack-ram-tool/pkg/ctl/common"
+	"github.com/AliyunContainerService/ack-ram-tool/pkg/types"
+)
+
+// envExecInfo is the name of the environment variable kubectl/client-go set
+// to pass the exec plugin its own ExecCredential request, per the
+// client-go exec plugin contract.
+const envExecInfo = "KUBERNETES_EXEC_INFO"
+
+// readExecInfo parses KUBERNETES_EXEC_INFO, if set, returning the
+// ExecCredential request kubectl made of us. A missing or unparsable
+// variable is not an error: we fall back to getCredentialOpts.apiVersion
+// (v1beta1; no subcommand sets it from a flag), same as if this were
+// invoked outside of an exec plugin context.
+func readExecInfo() *types.ExecCredential {
+	raw := os.Getenv(envExecInfo)
+	if raw == "" {
+		return nil
+	}
+
+	var execInfo types.ExecCredential
+	if err := json.Unmarshal([]byte(raw), &execInfo); err != nil {
+		common.Log.Warn("failed to parse " + envExecInfo + ": " + err.Error())
+		return nil
+	}
+	return &execInfo
+}
+
+// negotiateApiVersion returns the apiVersion to respond with: the one
+// kubectl asked for in execInfo when present, otherwise the group version
+// for getCredentialOpts.apiVersion.
+func negotiateApiVersion(execInfo *types.ExecCredential) string {
+	if execInfo != nil && execInfo.APIVersion != "" {
+		return execInfo.APIVersion
+	}
+	return getApiVersion(getCredentialOpts.apiVersion)
+}
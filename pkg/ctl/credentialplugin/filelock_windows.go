@@ -0,0 +1,40 @@
+//go:build windows
+
+package credentialplugin
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// fileLock is a cross-process advisory lock backed by a lock file, used to
+// ensure only one process refreshes the credential cache at a time.
+type fileLock struct {
+	f *os.File
+}
+
+// lockFile blocks until it can take an exclusive lock on path, creating the
+// file if necessary.
+func lockFile(path string) (*fileLock, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	ol := new(windows.Overlapped)
+	err = windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK, 0,
+		1, 0, ol)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &fileLock{f: f}, nil
+}
+
+func (l *fileLock) Unlock() error {
+	defer l.f.Close()
+	ol := new(windows.Overlapped)
+	return windows.UnlockFileEx(windows.Handle(l.f.Fd()), 0, 1, 0, ol)
+}
@@ -0,0 +1,107 @@
+package credentialplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/AliyunContainerService/ack-ram-tool/pkg/ctl/common"
+	"github.com/AliyunContainerService/ack-ram-tool/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+var getCredentialCmd = &cobra.Command{
+	Use:   "get-credential",
+	Short: "get credential in kubernetes exec credential format.",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		cred, err := getCachedCredential(ctx)
+		common.ExitIfError(err)
+
+		d, err := json.Marshal(cred)
+		common.ExitIfError(err)
+		fmt.Println(string(d))
+	},
+}
+
+func setupGetCredentialCmd(rootCmd *cobra.Command) {
+	rootCmd.AddCommand(getCredentialCmd)
+	getCredentialCmd.Flags().StringVarP(&getCredentialOpts.clusterId, "cluster-id", "c", "", "The cluster id to use")
+	err := getCredentialCmd.MarkFlagRequired("cluster-id")
+	common.ExitIfError(err)
+
+	getCredentialCmd.Flags().BoolVar(&getCredentialOpts.privateIpAddress, "private-address", getCredentialOpts.privateIpAddress, "Use private ip as api-server address")
+	getCredentialCmd.Flags().DurationVar(&getCredentialOpts.temporaryDuration, "expiration", getCredentialOpts.temporaryDuration, "The credential expiration")
+	getCredentialCmd.Flags().StringVar(&getCredentialOpts.cacheDir, "credential-cache-dir", getCredentialOpts.cacheDir, "Directory to cache credentials in, shared across processes")
+	getCredentialCmd.Flags().BoolVar(&getCredentialOpts.disableCache, "disable-credential-cache", false, "disable the on-disk credential cache")
+}
+
+// getCachedCredential returns an ExecCredential for the configured cluster,
+// serving it from the on-disk cache when possible. A cache miss takes a
+// cross-process lock before refreshing, so that a burst of concurrent
+// kubectl invocations against the same cluster results in a single
+// STS/OIDC exchange instead of a storm of them.
+func getCachedCredential(ctx context.Context) (*types.ExecCredential, error) {
+	execInfo := readExecInfo()
+	apiVersion := negotiateApiVersion(execInfo)
+
+	if getCredentialOpts.disableCache || getCredentialOpts.cacheDir == "" {
+		return getCredential(ctx, apiVersion)
+	}
+
+	cache := newFileCache(getCredentialOpts.cacheDir)
+	key := cacheKey(getCredentialOpts.clusterId, apiVersion)
+
+	if cred, ok := cache.get(key, cacheExpiryWindow, time.Now()); ok {
+		return cred, nil
+	}
+
+	if err := os.MkdirAll(cache.dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create credential cache dir %q: %w", cache.dir, err)
+	}
+
+	lock, err := lockFile(cache.lockPath())
+	if err != nil {
+		return nil, fmt.Errorf("acquire credential cache lock: %w", err)
+	}
+	defer lock.Unlock()
+
+	// another process may have refreshed the credential while we were
+	// waiting for the lock
+	if cred, ok := cache.get(key, cacheExpiryWindow, time.Now()); ok {
+		return cred, nil
+	}
+
+	cred, err := getCredential(ctx, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cache.set(key, cred); err != nil {
+		common.Log.Warn(fmt.Sprintf("cache credential failed: %s", err))
+	}
+
+	return cred, nil
+}
+
+func getCredential(ctx context.Context, apiVersion string) (*types.ExecCredential, error) {
+	client := common.GetClientOrDie()
+	token, expiration, err := client.GetToken(ctx, getCredentialOpts.clusterId,
+		getCredentialOpts.privateIpAddress, getCredentialOpts.temporaryDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ExecCredential{
+		Kind:       kindExecCredential,
+		APIVersion: apiVersion,
+		Status: &types.ExecCredentialStatus{
+			Token:               token,
+			ExpirationTimestamp: &expiration,
+		},
+	}, nil
+}
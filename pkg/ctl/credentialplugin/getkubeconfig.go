@@ -24,6 +24,8 @@ var getKubeconfigCmd = &cobra.Command{
 	Short: "get a kubeconfig with exec credential plugin format.",
 	Long:  ``,
 	Run: func(cmd *cobra.Command, args []string) {
+		common.ExitIfError(validateInteractiveMode(getCredentialOpts.interactiveMode))
+
 		client := common.GetClientOrDie()
 		ctx := context.Background()
 
@@ -56,8 +58,13 @@ func generateExecKubeconfig(config *types.KubeConfig) *types.KubeConfig {
 		getCredentialOpts.clusterId,
 		//"--api-version",
 		//getCredentialOpts.apiVersion,
-		//"--expiration",
-		//"1h",
+		"--expiration",
+		getCredentialOpts.temporaryDuration.String(),
+	}
+	if getCredentialOpts.disableCache {
+		args = append(args, "--disable-credential-cache")
+	} else if getCredentialOpts.cacheDir != "" {
+		args = append(args, "--credential-cache-dir", getCredentialOpts.cacheDir)
 	}
 	for _, u := range newConf.Users {
 		newU := types.KubeAuthUser{
@@ -68,8 +75,8 @@ func generateExecKubeconfig(config *types.KubeConfig) *types.KubeConfig {
 					Args:               args,
 					APIVersion:         getApiVersion(getCredentialOpts.apiVersion),
 					InstallHint:        "",
-					ProvideClusterInfo: false,
-					InteractiveMode:    types.NeverExecInteractiveMode,
+					ProvideClusterInfo: getCredentialOpts.provideClusterInfo,
+					InteractiveMode:    types.ExecInteractiveMode(getCredentialOpts.interactiveMode),
 				},
 			},
 		}
@@ -85,9 +92,11 @@ func setupGetKubeconfigCmd(rootCmd *cobra.Command) {
 	err := getKubeconfigCmd.MarkFlagRequired("cluster-id")
 	common.ExitIfError(err)
 
-	//getKubeconfigCmd.Flags().DurationVar(&getCredentialOpts.temporaryDuration, "expiration", time.Hour, "The credential expiration")
+	getKubeconfigCmd.Flags().DurationVar(&getCredentialOpts.temporaryDuration, "expiration", getCredentialOpts.temporaryDuration, "The credential expiration")
 	getKubeconfigCmd.Flags().BoolVar(&getCredentialOpts.privateIpAddress, "private-address", getCredentialOpts.privateIpAddress, "Use private ip as api-server address")
 	//getKubeconfigCmd.Flags().StringVar(&getCredentialOpts.apiVersion, "api-version", "v1beta1", "v1 or v1beta1")
-	//getKubeconfigCmd.Flags().StringVar(&getCredentialOpts.cacheDir, "credential-cache-dir", defaultCacheDir, "Directory to cache credential")
-	//getcredentialCmd.Flags().BoolVar(&getCredentialOpts.disableCache, "disable-credential-cache", false, "disable credential cache")
+	getKubeconfigCmd.Flags().StringVar(&getCredentialOpts.cacheDir, "credential-cache-dir", getCredentialOpts.cacheDir, "Directory to cache credentials in, shared across processes")
+	getKubeconfigCmd.Flags().BoolVar(&getCredentialOpts.disableCache, "disable-credential-cache", false, "disable the on-disk credential cache")
+	getKubeconfigCmd.Flags().BoolVar(&getCredentialOpts.provideClusterInfo, "provide-cluster-info", false, "provide cluster info (server, CA data) to the exec plugin")
+	getKubeconfigCmd.Flags().StringVar(&getCredentialOpts.interactiveMode, "interactive-mode", getCredentialOpts.interactiveMode, "when to run the exec plugin interactively: Never, IfAvailable or Always")
 }
\ No newline at end of file
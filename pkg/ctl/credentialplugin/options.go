@@ -0,0 +1,47 @@
+package credentialplugin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AliyunContainerService/ack-ram-tool/pkg/types"
+)
+
+// getCredentialOpts holds the flags shared by the get-kubeconfig and
+// get-credential subcommands.
+var getCredentialOpts = struct {
+	clusterId         string
+	privateIpAddress  bool
+	temporaryDuration time.Duration
+	apiVersion        string
+
+	cacheDir     string
+	disableCache bool
+
+	provideClusterInfo bool
+	interactiveMode    string
+}{
+	apiVersion:        versionV1beta1,
+	temporaryDuration: time.Hour,
+	cacheDir:          defaultCacheDir(),
+	interactiveMode:   string(types.NeverExecInteractiveMode),
+}
+
+func getApiVersion(v string) string {
+	if v == versionV1 {
+		return groupVersionV1
+	}
+	return groupVersionV1beta1
+}
+
+// validateInteractiveMode rejects anything but the three interactive
+// modes the client-go exec plugin contract defines, so a typo fails fast
+// here instead of producing a kubeconfig kubectl will reject at exec time.
+func validateInteractiveMode(v string) error {
+	switch types.ExecInteractiveMode(v) {
+	case types.NeverExecInteractiveMode, types.IfAvailableExecInteractiveMode, types.AlwaysExecInteractiveMode:
+		return nil
+	default:
+		return fmt.Errorf("invalid --interactive-mode %q: must be one of Never, IfAvailable, Always", v)
+	}
+}
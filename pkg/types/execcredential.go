@@ -0,0 +1,32 @@
+package types
+
+import "time"
+
+// ExecCredential is the input/output format used by the
+// client.authentication.k8s.io exec credential plugin protocol. See
+// https://kubernetes.io/docs/reference/access-authn-authz/authentication/#client-go-credential-plugins
+type ExecCredential struct {
+	Kind       string              `json:"kind"`
+	APIVersion string              `json:"apiVersion"`
+	Spec       ExecCredentialSpec  `json:"spec,omitempty"`
+	Status     *ExecCredentialStatus `json:"status,omitempty"`
+}
+
+type ExecCredentialSpec struct {
+	Cluster     *Cluster `json:"cluster,omitempty"`
+	Interactive bool     `json:"interactive,omitempty"`
+}
+
+type ExecCredentialStatus struct {
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+	Token                string    `json:"token,omitempty"`
+}
+
+// Cluster carries the cluster info kubectl passes to an exec plugin when
+// the plugin has ProvideClusterInfo set, mirroring the client-go exec
+// plugin contract.
+type Cluster struct {
+	Server                   string `json:"server,omitempty"`
+	CertificateAuthorityData []byte `json:"certificate-authority-data,omitempty"`
+	Config                   any    `json:"config,omitempty"`
+}
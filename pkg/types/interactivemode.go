@@ -0,0 +1,9 @@
+package types
+
+// IfAvailableExecInteractiveMode and AlwaysExecInteractiveMode complete the
+// set of interactive modes defined by the client-go exec plugin contract,
+// alongside the existing NeverExecInteractiveMode.
+const (
+	IfAvailableExecInteractiveMode ExecInteractiveMode = "IfAvailable"
+	AlwaysExecInteractiveMode      ExecInteractiveMode = "Always"
+)